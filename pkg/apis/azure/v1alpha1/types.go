@@ -0,0 +1,154 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationMigration, if set to "true" on a PublicIPAddress object, puts the actuator into migration mode
+// for that object: CreateOrUpdate keeps its status refreshed but performs no mutating Azure calls, and
+// Delete removes the finalizer without removing the address from its load balancer or deleting it. This
+// lets a control plane migration drop these objects on the source cluster without touching Azure resources
+// that the destination cluster will re-adopt.
+const AnnotationMigration = "remedy.gardener.cloud/migration"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PublicIPAddress is a specification for a PublicIPAddress resource.
+type PublicIPAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicIPAddressSpec   `json:"spec"`
+	Status PublicIPAddressStatus `json:"status"`
+}
+
+// PublicIPAddressSpec is the spec for a PublicIPAddress resource.
+type PublicIPAddressSpec struct {
+	// IPAddress is the single-stack IP address to remedy.
+	// Deprecated: Use IPAddresses and IPFamilies to describe dual-stack PublicIPAddress objects instead.
+	// This field is still populated for single-stack objects for backwards compatibility.
+	IPAddress string `json:"ipAddress"`
+	// IPFamilies lists the IP families that back this logical service PIP, one Azure PublicIPAddress
+	// resource per entry. If empty, the object is treated as single-stack and IPAddress is used instead.
+	// +optional
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
+	// IPAddresses holds one IP address per entry in IPFamilies, at the same index.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	// Name is the name of a pre-existing Azure public IP address to look up directly, e.g. one a caller
+	// resolved from a Service's service.beta.kubernetes.io/azure-pip-name annotation. Takes precedence over
+	// a name already resolved in the status. Nothing in this tree currently populates this field from that
+	// annotation automatically; see service.PublicIPAddressSpecForService.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// PrefixID is the ID of an Azure public IP prefix to resolve the address from, e.g. one a caller resolved
+	// from a Service's service.beta.kubernetes.io/azure-pip-prefix-id annotation. Only consulted if Name is
+	// unset. Nothing in this tree currently populates this field from that annotation automatically; see
+	// service.PublicIPAddressSpecForService.
+	// +optional
+	PrefixID *string `json:"prefixID,omitempty"`
+	// Managed specifies whether the Azure public IP address was allocated by this controller and may
+	// therefore be deleted by it. User-supplied public IP addresses (Name or PrefixID set and Managed false)
+	// are only ever removed from their load balancer, never deleted.
+	// This field has no defaulting webhook, so it is only consulted when Name or PrefixID is set; an object
+	// with neither set (including one that predates this field) is always treated as managed, regardless of
+	// its unmarshaled value, so existing single-stack objects keep being cleaned up as before.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
+}
+
+// PublicIPAddressStatus is the status for a PublicIPAddress resource.
+type PublicIPAddressStatus struct {
+	// Exists specifies if the PublicIPAddress exists.
+	// Deprecated: For dual-stack objects, consult IPs instead.
+	Exists bool `json:"exists"`
+	// ID is the ID of the Azure public IP address.
+	// Deprecated: For dual-stack objects, consult IPs instead.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the Azure public IP address.
+	// Deprecated: For dual-stack objects, consult IPs instead.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// ProvisioningState is the provisioning state of the Azure public IP address.
+	// Deprecated: For dual-stack objects, consult IPs instead.
+	// +optional
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	// IPs holds the resolution state of each Azure public IP address backing this object, keyed by IP family.
+	// +optional
+	IPs map[corev1.IPFamily]PublicIPAddressIPStatus `json:"ips,omitempty"`
+	// FailedOperations contains information about any operations on the PublicIPAddress that failed.
+	// +optional
+	FailedOperations []FailedOperation `json:"failedOperations,omitempty"`
+}
+
+// PublicIPAddressIPStatus is the per-family resolution status of an Azure public IP address.
+type PublicIPAddressIPStatus struct {
+	// Exists specifies if the Azure public IP address for this family exists.
+	Exists bool `json:"exists"`
+	// ID is the ID of the Azure public IP address.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the Azure public IP address.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// ProvisioningState is the provisioning state of the Azure public IP address.
+	// +optional
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+// FailedOperation contains information about an operation that failed, and how many times it has failed so far.
+type FailedOperation struct {
+	// Type is the type of the operation.
+	Type OperationType `json:"type"`
+	// IPFamily identifies the address family this failure applies to. Empty for single-stack objects.
+	// +optional
+	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
+	// Attempts is the number of times this operation has failed so far.
+	Attempts int `json:"attempts"`
+	// ErrorMessage is the error message of the last failed attempt.
+	ErrorMessage string `json:"errorMessage"`
+	// Timestamp is the timestamp of the last failed attempt.
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// OperationType is a type of operation.
+type OperationType string
+
+const (
+	// OperationTypeGetPublicIPAddress is a constant for the "get" operation type.
+	OperationTypeGetPublicIPAddress OperationType = "GetPublicIPAddress"
+	// OperationTypeReconcilePublicIPAddress is a constant for the "reconcile" operation type, issued to unstick
+	// a public IP address whose provisioning state is not terminal before it is deleted.
+	OperationTypeReconcilePublicIPAddress OperationType = "ReconcilePublicIPAddress"
+	// OperationTypeRemoveFromLoadBalancer is a constant for the "remove from load balancer" operation type.
+	OperationTypeRemoveFromLoadBalancer OperationType = "RemoveFromLoadBalancer"
+	// OperationTypeDeletePublicIPAddress is a constant for the "delete" operation type.
+	OperationTypeDeletePublicIPAddress OperationType = "DeletePublicIPAddress"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PublicIPAddressList is a list of PublicIPAddress resources.
+type PublicIPAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PublicIPAddress `json:"items"`
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureOrphanedPublicIPRemedyConfiguration contains configuration for the Azure orphaned public IP remedy controller.
+type AzureOrphanedPublicIPRemedyConfiguration struct {
+	// RequeueInterval is the interval after which a reconciliation is requeued following a recoverable error
+	// or an inconclusive state.
+	RequeueInterval metav1.Duration `json:"requeueInterval"`
+	// DeletionGracePeriod is the duration a PublicIPAddress must have been marked for deletion before the
+	// actuator actually removes the backing Azure resources.
+	DeletionGracePeriod metav1.Duration `json:"deletionGracePeriod"`
+	// MaxGetAttempts is the maximum number of times getting an Azure public IP address may fail before the
+	// actuator gives up and removes the finalizer without further retries.
+	MaxGetAttempts int `json:"maxGetAttempts"`
+	// MaxCleanAttempts is the maximum number of times removing an Azure public IP address from a load balancer
+	// or deleting it may fail before the actuator gives up and removes the finalizer without further retries.
+	MaxCleanAttempts int `json:"maxCleanAttempts"`
+	// MaxReconcileAttempts is the maximum number of times reconciling an Azure public IP address that is stuck
+	// in a non-terminal provisioning state may fail before the actuator gives up and removes the finalizer
+	// without further retries.
+	MaxReconcileAttempts int `json:"maxReconcileAttempts"`
+	// CacheTTL is the duration for which a listing of Azure public IP addresses may be served from an
+	// in-memory cache before it is refreshed from the Azure API. A zero value disables caching, so that every
+	// lookup issues its own Azure Resource Manager call as before.
+	CacheTTL metav1.Duration `json:"cacheTTL"`
+	// MigrationMode, if true, puts the actuator into migration mode for every PublicIPAddress object it
+	// reconciles, regardless of whether the individual object carries the
+	// azurev1alpha1.AnnotationMigration annotation. Intended to be set shoot-wide while a control plane
+	// migration is in progress.
+	MigrationMode bool `json:"migrationMode"`
+}
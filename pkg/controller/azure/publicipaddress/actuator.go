@@ -0,0 +1,605 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publicipaddress
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-11-01/network"
+	controllererror "github.com/gardener/gardener/extensions/pkg/controller/error"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/gardener/remedy-controller/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/remedy-controller/pkg/apis/config"
+	"github.com/gardener/remedy-controller/pkg/controller"
+	"github.com/gardener/remedy-controller/pkg/utils"
+	azureutils "github.com/gardener/remedy-controller/pkg/utils/azure"
+	"github.com/gardener/remedy-controller/pkg/utils/azure/pubipcache"
+)
+
+// actuator reconciles azurev1alpha1.PublicIPAddress objects against Azure.
+type actuator struct {
+	pubipUtils                  azureutils.PublicIPAddressUtils
+	config                      config.AzureOrphanedPublicIPRemedyConfiguration
+	timestamper                 utils.Timestamper
+	logger                      logr.Logger
+	cleanedIPsCounter           prometheus.Counter
+	suppressedOperationsCounter prometheus.Counter
+	client                      client.Client
+}
+
+// NewActuator creates a new controller.Actuator that reconciles azurev1alpha1.PublicIPAddress objects
+// against Azure using the given PublicIPAddressUtils. If config.CacheTTL is greater than zero, lookups are
+// served from the caching PublicIPAddressUtils that pubipcache.New wraps around pubipUtils instead of
+// pubipUtils directly.
+func NewActuator(
+	pubipUtils azureutils.PublicIPAddressUtils,
+	config config.AzureOrphanedPublicIPRemedyConfiguration,
+	timestamper utils.Timestamper,
+	logger logr.Logger,
+	cleanedIPsCounter prometheus.Counter,
+	suppressedOperationsCounter prometheus.Counter,
+	cacheMetrics pubipcache.Metrics,
+) controller.Actuator {
+	if config.CacheTTL.Duration > 0 {
+		pubipUtils = pubipcache.New(pubipUtils, config.CacheTTL.Duration, timestamper, cacheMetrics)
+	}
+	return &actuator{
+		pubipUtils:                  pubipUtils,
+		config:                      config,
+		timestamper:                 timestamper,
+		logger:                      logger,
+		cleanedIPsCounter:           cleanedIPsCounter,
+		suppressedOperationsCounter: suppressedOperationsCounter,
+	}
+}
+
+// migrationMode reports whether mutating Azure calls must be suppressed for the given object, either
+// because the shoot-wide config.MigrationMode flag is set or because the object itself carries the
+// azurev1alpha1.AnnotationMigration annotation.
+func (a *actuator) migrationMode(pubip *azurev1alpha1.PublicIPAddress) bool {
+	return a.config.MigrationMode || pubip.Annotations[azurev1alpha1.AnnotationMigration] == "true"
+}
+
+// managed reports whether the actuator may delete the Azure public IP address backing pubip, as opposed to
+// only removing it from its load balancer. Spec.Managed has no defaulting webhook, so an object with neither
+// Spec.Name nor Spec.PrefixID set (including one that predates these fields) is always treated as managed,
+// regardless of its unmarshaled value, rather than trusting the bool's zero value.
+func (a *actuator) managed(pubip *azurev1alpha1.PublicIPAddress) bool {
+	if pubip.Spec.Name != nil || pubip.Spec.PrefixID != nil {
+		return pubip.Spec.Managed
+	}
+	return true
+}
+
+// InjectClient injects the given client into the actuator.
+func (a *actuator) InjectClient(c client.Client) error {
+	a.client = c
+	return nil
+}
+
+// CreateOrUpdate reconciles the Azure public IP address(es) backing the given PublicIPAddress object and
+// updates its status accordingly. It never performs mutating Azure calls, with or without migration mode
+// (see azurev1alpha1.AnnotationMigration), so the object's status is always kept up to date.
+func (a *actuator) CreateOrUpdate(ctx context.Context, obj runtime.Object) (time.Duration, bool, error) {
+	return a.createOrUpdate(ctx, obj)
+}
+
+// Delete cleans up the Azure public IP address(es) backing the given PublicIPAddress object, which is
+// being deleted.
+func (a *actuator) Delete(ctx context.Context, obj runtime.Object) error {
+	return a.delete(ctx, obj)
+}
+
+func asPublicIPAddress(obj runtime.Object) (*azurev1alpha1.PublicIPAddress, error) {
+	pubip, ok := obj.(*azurev1alpha1.PublicIPAddress)
+	if !ok {
+		return nil, fmt.Errorf("reconciled object %T is not a PublicIPAddress", obj)
+	}
+	return pubip, nil
+}
+
+func (a *actuator) createOrUpdate(ctx context.Context, obj runtime.Object) (time.Duration, bool, error) {
+	pubip, err := asPublicIPAddress(obj)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(pubip.Spec.IPFamilies) > 0 {
+		return a.createOrUpdateDualStack(ctx, pubip)
+	}
+	return a.createOrUpdateSingleStack(ctx, pubip)
+}
+
+func (a *actuator) createOrUpdateSingleStack(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress) (time.Duration, bool, error) {
+	azureIP, err := a.getAzurePublicIPAddress(ctx, pubip)
+	if err != nil {
+		return 0, false, a.recordLookupFailure(ctx, pubip, "", err)
+	}
+
+	if _, err := a.syncStatus(ctx, pubip, azureIP, ""); err != nil {
+		return 0, false, err
+	}
+
+	var requeueAfter time.Duration
+	if azureIP == nil {
+		requeueAfter = a.config.RequeueInterval.Duration
+	}
+	return requeueAfter, false, nil
+}
+
+// createOrUpdateDualStack resolves one Azure public IP address per entry in pubip.Spec.IPFamilies and
+// aggregates the result into pubip.Status.IPs, keyed by family. A lookup failure for one family does not
+// prevent the others from being resolved and reported; the first such failure is surfaced as the returned
+// error once every family has been attempted, unless that family's lookup has already failed more than
+// MaxGetAttempts times, in which case it is reported with its last known status and otherwise ignored.
+func (a *actuator) createOrUpdateDualStack(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress) (time.Duration, bool, error) {
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip); err != nil {
+		return 0, false, errors.Wrap(err, "could not get publicipaddress")
+	}
+
+	var (
+		newIPs = make(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus, len(pubip.Spec.IPFamilies))
+		// Copied so that bumping an existing entry's attempts shows up as a status change below.
+		failedOperations = append([]azurev1alpha1.FailedOperation(nil), pubip.Status.FailedOperations...)
+		requeueAfter     time.Duration
+		firstErr         error
+	)
+
+	for i, family := range pubip.Spec.IPFamilies {
+		var ip string
+		if i < len(pubip.Spec.IPAddresses) {
+			ip = pubip.Spec.IPAddresses[i]
+		}
+		existing := pubip.Status.IPs[family]
+
+		azureIP, err := a.getAzurePublicIPAddressByNameOrIP(ctx, existing.Name, ip)
+		if err != nil {
+			failedOperations = addFailedOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family, err, a.timestamper.Now())
+			newIPs[family] = existing
+			if attemptsForOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family) > a.config.MaxGetAttempts {
+				// Give up on this family: keep reporting its last known status, but stop requeuing and
+				// blocking the other families on it.
+				continue
+			}
+			requeueAfter = a.config.RequeueInterval.Duration
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		failedOperations = removeFailedOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family)
+
+		if azureIP != nil {
+			newIPs[family] = azurev1alpha1.PublicIPAddressIPStatus{
+				Exists:            true,
+				ID:                azureIP.ID,
+				Name:              azureIP.Name,
+				ProvisioningState: azureIP.ProvisioningState,
+			}
+		} else {
+			requeueAfter = a.config.RequeueInterval.Duration
+		}
+	}
+
+	newStatus := pubip.Status.DeepCopy()
+	newStatus.IPs = newIPs
+	newStatus.FailedOperations = failedOperations
+
+	if !reflect.DeepEqual(pubip.Status, *newStatus) {
+		pubip.Status = *newStatus
+		if err := a.client.Status().Update(ctx, pubip); err != nil {
+			return 0, false, errors.Wrap(err, "could not update publicipaddress status")
+		}
+	}
+
+	if firstErr != nil {
+		return 0, false, &controllererror.RequeueAfterError{Cause: firstErr, RequeueAfter: a.config.RequeueInterval.Duration}
+	}
+	return requeueAfter, false, nil
+}
+
+func (a *actuator) delete(ctx context.Context, obj runtime.Object) error {
+	pubip, err := asPublicIPAddress(obj)
+	if err != nil {
+		return err
+	}
+	if len(pubip.Spec.IPFamilies) > 0 {
+		return a.deleteDualStack(ctx, pubip)
+	}
+	return a.deleteSingleStack(ctx, pubip)
+}
+
+// deleteSingleStack resolves the Azure public IP address for pubip and, once it is safe to do so, removes it
+// from its load balancer and deletes it. In migration mode (see azurev1alpha1.AnnotationMigration), it
+// still refreshes the object's status but returns immediately afterwards without touching Azure, so the
+// finalizer can be dropped.
+func (a *actuator) deleteSingleStack(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress) error {
+	azureIP, err := a.getAzurePublicIPAddress(ctx, pubip)
+	if err != nil {
+		return a.recordLookupFailure(ctx, pubip, "", err)
+	}
+
+	migrating := a.migrationMode(pubip)
+
+	if !migrating && azureIP != nil && pubip.DeletionTimestamp != nil &&
+		a.timestamper.Now().Sub(pubip.DeletionTimestamp.Time) < a.config.DeletionGracePeriod.Duration {
+		return &controllererror.RequeueAfterError{
+			Cause:        errors.New("public IP address still exists"),
+			RequeueAfter: a.config.RequeueInterval.Duration,
+		}
+	}
+
+	if _, err := a.syncStatus(ctx, pubip, azureIP, ""); err != nil {
+		return err
+	}
+	if azureIP == nil {
+		return nil
+	}
+
+	if migrating {
+		a.suppressedOperationsCounter.Inc()
+		return nil
+	}
+
+	if !provisioningSucceeded(azureIP) {
+		if _, err := a.pubipUtils.Reconcile(ctx, *azureIP.Name); err != nil {
+			return a.recordOperationFailure(
+				ctx, pubip, azurev1alpha1.OperationTypeReconcilePublicIPAddress, "",
+				errors.Wrap(err, "could not reconcile Azure public IP address"),
+				a.config.MaxReconcileAttempts,
+			)
+		}
+		// The reconcile call itself succeeded, but the resource has not reached a terminal provisioning
+		// state yet. Track this the same way as a failed reconcile call so that a public IP address stuck
+		// in a non-terminal state forever still gives up after MaxReconcileAttempts instead of requeuing
+		// indefinitely.
+		return a.recordOperationFailure(
+			ctx, pubip, azurev1alpha1.OperationTypeReconcilePublicIPAddress, "",
+			errors.New("public IP address provisioning still in progress"),
+			a.config.MaxReconcileAttempts,
+		)
+	}
+
+	if err := a.pubipUtils.RemoveFromLoadBalancer(ctx, []string{*azureIP.ID}); err != nil {
+		return a.recordOperationFailure(
+			ctx, pubip, azurev1alpha1.OperationTypeRemoveFromLoadBalancer, "",
+			errors.Wrap(err, "could not remove Azure public IP address from the load balancer"),
+			a.config.MaxCleanAttempts,
+		)
+	}
+
+	if a.managed(pubip) {
+		if err := a.pubipUtils.Delete(ctx, *azureIP.Name); err != nil {
+			return a.recordOperationFailure(
+				ctx, pubip, azurev1alpha1.OperationTypeDeletePublicIPAddress, "",
+				errors.Wrap(err, "could not delete Azure public IP address"),
+				a.config.MaxCleanAttempts,
+			)
+		}
+	}
+
+	a.cleanedIPsCounter.Inc()
+	return nil
+}
+
+type resolvedFamilyIP struct {
+	family  corev1.IPFamily
+	azureIP *network.PublicIPAddress
+}
+
+// deleteDualStack resolves the Azure public IP address of every family tracked by pubip, removes all of
+// them from their load balancer in a single call, and only then deletes the individual Azure resources.
+// The finalizer may only be dropped by the caller once this returns nil, i.e. once every family reports
+// gone. In migration mode (see azurev1alpha1.AnnotationMigration), it still resolves and records the
+// status of every family, but returns immediately afterwards without touching Azure. A family whose lookup
+// or reconcile keeps failing past MaxGetAttempts/MaxReconcileAttempts is given up on and excluded from
+// further processing, rather than blocking the finalizer or the other families forever.
+func (a *actuator) deleteDualStack(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress) error {
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip); err != nil {
+		return errors.Wrap(err, "could not get publicipaddress")
+	}
+
+	migrating := a.migrationMode(pubip)
+
+	var (
+		resolved []resolvedFamilyIP
+		// Copied so that bumping an existing entry's attempts shows up as a status change below.
+		failedOperations = append([]azurev1alpha1.FailedOperation(nil), pubip.Status.FailedOperations...)
+		firstErr         error
+	)
+
+	for i, family := range pubip.Spec.IPFamilies {
+		var ip string
+		if i < len(pubip.Spec.IPAddresses) {
+			ip = pubip.Spec.IPAddresses[i]
+		}
+		existing := pubip.Status.IPs[family]
+
+		azureIP, err := a.getAzurePublicIPAddressByNameOrIP(ctx, existing.Name, ip)
+		if err != nil {
+			failedOperations = addFailedOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family, err, a.timestamper.Now())
+			if attemptsForOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family) > a.config.MaxGetAttempts {
+				// Give up on this family: leave it out of resolved so it is not touched further, without
+				// blocking the other families or the finalizer on it.
+				continue
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		failedOperations = removeFailedOperation(failedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family)
+
+		if azureIP == nil {
+			continue
+		}
+
+		if !migrating && !provisioningSucceeded(azureIP) {
+			var reconcileErr error
+			if _, err := a.pubipUtils.Reconcile(ctx, *azureIP.Name); err != nil {
+				reconcileErr = err
+			} else {
+				// The reconcile call itself succeeded, but the resource has not reached a terminal
+				// provisioning state yet. Track this the same way as a failed reconcile call so that a
+				// family stuck in a non-terminal state forever still gives up after MaxReconcileAttempts
+				// instead of requeuing indefinitely.
+				reconcileErr = errors.New("public IP address provisioning still in progress")
+			}
+			failedOperations = addFailedOperation(failedOperations, azurev1alpha1.OperationTypeReconcilePublicIPAddress, family, reconcileErr, a.timestamper.Now())
+			if attemptsForOperation(failedOperations, azurev1alpha1.OperationTypeReconcilePublicIPAddress, family) > a.config.MaxReconcileAttempts {
+				// Give up on this family: leave it out of resolved so it is not touched further, without
+				// blocking the other families or the finalizer on it.
+				continue
+			}
+			if firstErr == nil {
+				firstErr = reconcileErr
+			}
+			continue
+		}
+
+		resolved = append(resolved, resolvedFamilyIP{family: family, azureIP: azureIP})
+	}
+
+	if !migrating && firstErr == nil && len(resolved) > 0 && pubip.DeletionTimestamp != nil &&
+		a.timestamper.Now().Sub(pubip.DeletionTimestamp.Time) < a.config.DeletionGracePeriod.Duration {
+		return &controllererror.RequeueAfterError{
+			Cause:        errors.New("public IP address still exists"),
+			RequeueAfter: a.config.RequeueInterval.Duration,
+		}
+	}
+
+	newIPs := make(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus, len(pubip.Spec.IPFamilies))
+	for _, r := range resolved {
+		newIPs[r.family] = azurev1alpha1.PublicIPAddressIPStatus{
+			Exists:            true,
+			ID:                r.azureIP.ID,
+			Name:              r.azureIP.Name,
+			ProvisioningState: r.azureIP.ProvisioningState,
+		}
+	}
+	newStatus := pubip.Status.DeepCopy()
+	newStatus.IPs = newIPs
+	newStatus.FailedOperations = failedOperations
+
+	if !reflect.DeepEqual(pubip.Status, *newStatus) {
+		pubip.Status = *newStatus
+		if err := a.client.Status().Update(ctx, pubip); err != nil {
+			return errors.Wrap(err, "could not update publicipaddress status")
+		}
+	}
+
+	if firstErr != nil {
+		return &controllererror.RequeueAfterError{Cause: firstErr, RequeueAfter: a.config.RequeueInterval.Duration}
+	}
+	if migrating {
+		a.suppressedOperationsCounter.Inc()
+		return nil
+	}
+	if len(resolved) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(resolved))
+	for _, r := range resolved {
+		ids = append(ids, *r.azureIP.ID)
+	}
+	if err := a.pubipUtils.RemoveFromLoadBalancer(ctx, ids); err != nil {
+		return a.recordOperationFailure(
+			ctx, pubip, azurev1alpha1.OperationTypeRemoveFromLoadBalancer, "",
+			errors.Wrap(err, "could not remove Azure public IP address from the load balancer"),
+			a.config.MaxCleanAttempts,
+		)
+	}
+
+	if a.managed(pubip) {
+		for _, r := range resolved {
+			if err := a.pubipUtils.Delete(ctx, *r.azureIP.Name); err != nil {
+				return a.recordOperationFailure(
+					ctx, pubip, azurev1alpha1.OperationTypeDeletePublicIPAddress, r.family,
+					errors.Wrap(err, "could not delete Azure public IP address"),
+					a.config.MaxCleanAttempts,
+				)
+			}
+		}
+	}
+
+	a.cleanedIPsCounter.Inc()
+	return nil
+}
+
+// provisioningSucceeded reports whether the given Azure public IP address has reached a terminal, successful
+// provisioning state. A nil state is treated as terminal since some Azure API responses omit it.
+func provisioningSucceeded(azureIP *network.PublicIPAddress) bool {
+	return azureIP.ProvisioningState == nil || *azureIP.ProvisioningState == string(network.Succeeded)
+}
+
+// getAzurePublicIPAddress looks up the Azure public IP address for the given object. Spec.Name, if set,
+// takes precedence over any name already resolved in the status, e.g. for a user-specified BYO public IP
+// address. Failing that, Spec.PrefixID is consulted to resolve the address from an Azure public IP prefix.
+// Otherwise, it prefers a lookup by name once the object's status has recorded one, since the IP address
+// alone may no longer be bound to the Azure resource at that point (e.g. after it was released from a load
+// balancer).
+func (a *actuator) getAzurePublicIPAddress(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress) (*network.PublicIPAddress, error) {
+	if pubip.Spec.Name != nil {
+		return a.getAzurePublicIPAddressByNameOrIP(ctx, pubip.Spec.Name, pubip.Spec.IPAddress)
+	}
+	if pubip.Spec.PrefixID != nil {
+		return a.getAzurePublicIPAddressByPrefix(ctx, *pubip.Spec.PrefixID, pubip.Status.Name, pubip.Spec.IPAddress)
+	}
+	return a.getAzurePublicIPAddressByNameOrIP(ctx, pubip.Status.Name, pubip.Spec.IPAddress)
+}
+
+// getAzurePublicIPAddressByPrefix enumerates the public IP addresses allocated from the given Azure public IP
+// prefix and returns the one matching name. If name is nil, i.e. it has not yet been resolved, it
+// disambiguates among the prefix's children by matching ip instead, since a single prefix commonly backs
+// more than one PublicIPAddress object; only if ip matches none of them either does it fall back to the
+// first child found.
+func (a *actuator) getAzurePublicIPAddressByPrefix(ctx context.Context, prefixID string, name *string, ip string) (*network.PublicIPAddress, error) {
+	children, err := a.pubipUtils.ListByPrefix(ctx, prefixID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list Azure public IP addresses by prefix")
+	}
+
+	if name != nil {
+		for _, child := range children {
+			if child.Name != nil && *child.Name == *name {
+				return child, nil
+			}
+		}
+		return nil, nil
+	}
+
+	if ip != "" {
+		for _, child := range children {
+			if child.PublicIPAddressPropertiesFormat != nil && child.IPAddress != nil && *child.IPAddress == ip {
+				return child, nil
+			}
+		}
+	}
+
+	if len(children) > 0 {
+		return children[0], nil
+	}
+	return nil, nil
+}
+
+// getAzurePublicIPAddressByNameOrIP looks up a single Azure public IP address, preferring a lookup by name
+// once one has already been resolved, since the IP address alone may no longer be bound to the Azure
+// resource at that point (e.g. after it was released from a load balancer).
+func (a *actuator) getAzurePublicIPAddressByNameOrIP(ctx context.Context, name *string, ip string) (*network.PublicIPAddress, error) {
+	if name != nil {
+		azureIP, err := a.pubipUtils.GetByName(ctx, *name)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get Azure public IP address by name")
+		}
+		return azureIP, nil
+	}
+	azureIP, err := a.pubipUtils.GetByIP(ctx, ip)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get Azure public IP address by IP")
+	}
+	return azureIP, nil
+}
+
+// syncStatus refreshes pubip from the API server and, if the given Azure public IP address resolution
+// differs from the object's current status, updates both the in-memory object and its status subresource.
+// It returns whether the status was changed.
+func (a *actuator) syncStatus(
+	ctx context.Context,
+	pubip *azurev1alpha1.PublicIPAddress,
+	azureIP *network.PublicIPAddress,
+	family corev1.IPFamily,
+) (bool, error) {
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip); err != nil {
+		return false, errors.Wrap(err, "could not get publicipaddress")
+	}
+
+	newStatus := pubip.Status.DeepCopy()
+	if azureIP != nil {
+		newStatus.Exists = true
+		newStatus.ID = azureIP.ID
+		newStatus.Name = azureIP.Name
+		newStatus.ProvisioningState = azureIP.ProvisioningState
+	} else {
+		newStatus.Exists = false
+		newStatus.ID = nil
+		newStatus.Name = nil
+		newStatus.ProvisioningState = nil
+	}
+	newStatus.FailedOperations = removeFailedOperation(newStatus.FailedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family)
+
+	if reflect.DeepEqual(pubip.Status, *newStatus) {
+		return false, nil
+	}
+
+	pubip.Status = *newStatus
+	if err := a.client.Status().Update(ctx, pubip); err != nil {
+		return false, errors.Wrap(err, "could not update publicipaddress status")
+	}
+	return true, nil
+}
+
+// recordLookupFailure refreshes pubip, records a failed get operation in its status and returns a
+// RequeueAfterError, unless the number of attempts already exceeds MaxGetAttempts, in which case it gives
+// up and returns nil so the caller can proceed with removing the finalizer.
+func (a *actuator) recordLookupFailure(ctx context.Context, pubip *azurev1alpha1.PublicIPAddress, family corev1.IPFamily, err error) error {
+	if getErr := a.client.Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip); getErr != nil {
+		return errors.Wrap(getErr, "could not get publicipaddress")
+	}
+
+	pubip.Status.FailedOperations = addFailedOperation(pubip.Status.FailedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family, err, a.timestamper.Now())
+	attempts := attemptsForOperation(pubip.Status.FailedOperations, azurev1alpha1.OperationTypeGetPublicIPAddress, family)
+
+	if updateErr := a.client.Status().Update(ctx, pubip); updateErr != nil {
+		return errors.Wrap(updateErr, "could not update publicipaddress status")
+	}
+
+	if attempts > a.config.MaxGetAttempts {
+		return nil
+	}
+	return &controllererror.RequeueAfterError{Cause: err, RequeueAfter: a.config.RequeueInterval.Duration}
+}
+
+// recordOperationFailure records a failed operation of the given type in pubip's status (already refreshed
+// by a prior syncStatus call in the same reconciliation) and returns a RequeueAfterError, unless the number
+// of attempts already exceeds maxAttempts, in which case it gives up and returns nil.
+func (a *actuator) recordOperationFailure(
+	ctx context.Context,
+	pubip *azurev1alpha1.PublicIPAddress,
+	opType azurev1alpha1.OperationType,
+	family corev1.IPFamily,
+	err error,
+	maxAttempts int,
+) error {
+	pubip.Status.FailedOperations = addFailedOperation(pubip.Status.FailedOperations, opType, family, err, a.timestamper.Now())
+	attempts := attemptsForOperation(pubip.Status.FailedOperations, opType, family)
+
+	if updateErr := a.client.Status().Update(ctx, pubip); updateErr != nil {
+		return errors.Wrap(updateErr, "could not update publicipaddress status")
+	}
+
+	if attempts > maxAttempts {
+		return nil
+	}
+	return &controllererror.RequeueAfterError{Cause: err, RequeueAfter: a.config.RequeueInterval.Duration}
+}
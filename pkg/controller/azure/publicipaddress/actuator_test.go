@@ -28,6 +28,7 @@ import (
 	mockprometheus "github.com/gardener/remedy-controller/pkg/mock/prometheus"
 	mockutilsazure "github.com/gardener/remedy-controller/pkg/mock/remedy-controller/utils/azure"
 	"github.com/gardener/remedy-controller/pkg/utils"
+	"github.com/gardener/remedy-controller/pkg/utils/azure/pubipcache"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-11-01/network"
 	"github.com/go-logr/logr"
@@ -35,6 +36,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -58,10 +60,11 @@ var _ = Describe("Actuator", func() {
 		ctrl *gomock.Controller
 		ctx  context.Context
 
-		c                 *mockclient.MockClient
-		sw                *mockclient.MockStatusWriter
-		pubipUtils        *mockutilsazure.MockPublicIPAddressUtils
-		cleanedIPsCounter *mockprometheus.MockCounter
+		c                           *mockclient.MockClient
+		sw                          *mockclient.MockStatusWriter
+		pubipUtils                  *mockutilsazure.MockPublicIPAddressUtils
+		cleanedIPsCounter           *mockprometheus.MockCounter
+		suppressedOperationsCounter *mockprometheus.MockCounter
 
 		cfg         config.AzureOrphanedPublicIPRemedyConfiguration
 		now         metav1.Time
@@ -84,17 +87,19 @@ var _ = Describe("Actuator", func() {
 		c.EXPECT().Status().Return(sw).AnyTimes()
 		pubipUtils = mockutilsazure.NewMockPublicIPAddressUtils(ctrl)
 		cleanedIPsCounter = mockprometheus.NewMockCounter(ctrl)
+		suppressedOperationsCounter = mockprometheus.NewMockCounter(ctrl)
 
 		cfg = config.AzureOrphanedPublicIPRemedyConfiguration{
-			RequeueInterval:     metav1.Duration{Duration: requeueInterval},
-			DeletionGracePeriod: metav1.Duration{Duration: deletionGracePeriod},
-			MaxGetAttempts:      2,
-			MaxCleanAttempts:    2,
+			RequeueInterval:      metav1.Duration{Duration: requeueInterval},
+			DeletionGracePeriod:  metav1.Duration{Duration: deletionGracePeriod},
+			MaxGetAttempts:       2,
+			MaxCleanAttempts:     2,
+			MaxReconcileAttempts: 2,
 		}
 		now = metav1.Now()
 		timestamper = utils.TimestamperFunc(func() metav1.Time { return now })
 		logger = log.Log.WithName("test")
-		actuator = publicipaddress.NewActuator(pubipUtils, cfg, timestamper, logger, cleanedIPsCounter)
+		actuator = publicipaddress.NewActuator(pubipUtils, cfg, timestamper, logger, cleanedIPsCounter, suppressedOperationsCounter, pubipcache.Metrics{})
 		Expect(actuator.(inject.Client).InjectClient(c)).To(Succeed())
 
 		earlyDeletionTimestamp = metav1.NewTime(now.Add(-10 * time.Minute))
@@ -118,6 +123,7 @@ var _ = Describe("Actuator", func() {
 				},
 				Spec: azurev1alpha1.PublicIPAddressSpec{
 					IPAddress: ip,
+					Managed:   true,
 				},
 				Status: status,
 			}
@@ -255,6 +261,20 @@ var _ = Describe("Actuator", func() {
 			Expect(actuator.Delete(ctx, pubipWithStatus)).Should(Succeed())
 		})
 
+		It("should still delete the Azure public IP address for a pre-existing object with Managed unset", func() {
+			pubipWithStatus := newPubip(true, nil, &earlyDeletionTimestamp)
+			pubipWithStatus.Spec.Managed = false // zero value, as for an object that predates this field
+
+			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(azurePublicIPAddress, nil)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubipWithStatus.Namespace, Name: pubipWithStatus.Name}, pubipWithStatus).Return(nil)
+
+			pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{string(azurePublicIPAddressID)}).Return(nil)
+			pubipUtils.EXPECT().Delete(ctx, azurePublicIPAddressName).Return(nil)
+			cleanedIPsCounter.EXPECT().Inc()
+
+			Expect(actuator.Delete(ctx, pubipWithStatus)).Should(Succeed())
+		})
+
 		It("should update the PublicIPAddress object status if the IP is not found and the status is already initialized", func() {
 			pubipWithStatus := newPubip(true, nil, &earlyDeletionTimestamp)
 			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(nil, nil)
@@ -349,5 +369,558 @@ var _ = Describe("Actuator", func() {
 			Expect(requeuAfterError.Cause).To(MatchError("could not remove Azure public IP address from the load balancer: test"))
 			Expect(requeuAfterError.RequeueAfter).To(Equal(cfg.RequeueInterval.Duration))
 		})
+
+		It("should reconcile and requeue instead of deleting if the Azure IP address is not in a terminal provisioning state", func() {
+			pubip := newPubip(true, nil, &earlyDeletionTimestamp)
+			stuckAzurePublicIPAddress := &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azurePublicIPAddressID),
+				Name: pointer.StringPtr(azurePublicIPAddressName),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ip),
+					ProvisioningState: pointer.StringPtr(string(network.Updating)),
+				},
+			}
+			pubipWithFailedOps := newPubip(
+				true,
+				[]azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeReconcilePublicIPAddress,
+						Attempts:     1,
+						ErrorMessage: "public IP address provisioning still in progress",
+						Timestamp:    now,
+					},
+				},
+				&earlyDeletionTimestamp,
+			)
+			pubipWithFailedOps.Status.ProvisioningState = pointer.StringPtr(string(network.Updating))
+
+			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			pubipUtils.EXPECT().Reconcile(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			sw.EXPECT().Update(ctx, pubipWithFailedOps).Return(nil)
+
+			err := actuator.Delete(ctx, pubip)
+			Expect(err).Should(HaveOccurred())
+
+			requeuAfterError, ok := err.(*controllererror.RequeueAfterError)
+			Expect(ok).To(BeTrue())
+
+			Expect(requeuAfterError.Cause).To(MatchError("public IP address provisioning still in progress"))
+			Expect(requeuAfterError.RequeueAfter).To(Equal(cfg.RequeueInterval.Duration))
+		})
+
+		It("should not fail if the Azure IP address remains stuck in a non-terminal provisioning state after repeated reconciles and no attempts remain", func() {
+			pubip := newPubip(
+				true,
+				[]azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeReconcilePublicIPAddress,
+						Attempts:     cfg.MaxReconcileAttempts + 1,
+						ErrorMessage: "public IP address provisioning still in progress",
+						Timestamp:    now,
+					},
+				},
+				&earlyDeletionTimestamp,
+			)
+			stuckAzurePublicIPAddress := &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azurePublicIPAddressID),
+				Name: pointer.StringPtr(azurePublicIPAddressName),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ip),
+					ProvisioningState: pointer.StringPtr(string(network.Updating)),
+				},
+			}
+			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			pubipUtils.EXPECT().Reconcile(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+		})
+
+		It("should requeue if reconciling the Azure IP address fails and attempts remain", func() {
+			pubip := newPubip(true, nil, &earlyDeletionTimestamp)
+			stuckAzurePublicIPAddress := &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azurePublicIPAddressID),
+				Name: pointer.StringPtr(azurePublicIPAddressName),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ip),
+					ProvisioningState: pointer.StringPtr(string(network.Failed)),
+				},
+			}
+			pubipWithFailedOps := newPubip(
+				true,
+				[]azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeReconcilePublicIPAddress,
+						Attempts:     1,
+						ErrorMessage: "could not reconcile Azure public IP address: test",
+						Timestamp:    now,
+					},
+				},
+				&earlyDeletionTimestamp,
+			)
+			pubipWithFailedOps.Status.ProvisioningState = pointer.StringPtr(string(network.Failed))
+			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			pubipUtils.EXPECT().Reconcile(ctx, azurePublicIPAddressName).Return(nil, errors.New("test"))
+			sw.EXPECT().Update(ctx, pubipWithFailedOps).Return(nil)
+
+			err := actuator.Delete(ctx, pubip)
+			Expect(err).Should(HaveOccurred())
+
+			requeuAfterError, ok := err.(*controllererror.RequeueAfterError)
+			Expect(ok).To(BeTrue())
+
+			Expect(requeuAfterError.Cause).To(MatchError("could not reconcile Azure public IP address: test"))
+			Expect(requeuAfterError.RequeueAfter).To(Equal(cfg.RequeueInterval.Duration))
+		})
+
+		It("should not fail if reconciling the Azure IP address fails and no attempts remain", func() {
+			pubip := newPubip(
+				true,
+				[]azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeReconcilePublicIPAddress,
+						Attempts:     cfg.MaxReconcileAttempts + 1,
+						ErrorMessage: "could not reconcile Azure public IP address: test",
+						Timestamp:    now,
+					},
+				},
+				&earlyDeletionTimestamp,
+			)
+			stuckAzurePublicIPAddress := &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azurePublicIPAddressID),
+				Name: pointer.StringPtr(azurePublicIPAddressName),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ip),
+					ProvisioningState: pointer.StringPtr(string(network.Failed)),
+				},
+			}
+			pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(stuckAzurePublicIPAddress, nil)
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			pubipUtils.EXPECT().Reconcile(ctx, azurePublicIPAddressName).Return(nil, errors.New("test"))
+			sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+			Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+		})
+	})
+
+	Describe("dual-stack", func() {
+		const (
+			ipv4          = "1.2.3.4"
+			ipv6          = "2001:db8::1"
+			azureIPv4ID   = "/subscriptions/xxx/resourceGroups/shoot--dev--test/providers/Microsoft.Network/publicIPAddresses/shoot--dev--test-ip-v4"
+			azureIPv4Name = "shoot--dev--test-ip-v4"
+			azureIPv6ID   = "/subscriptions/xxx/resourceGroups/shoot--dev--test/providers/Microsoft.Network/publicIPAddresses/shoot--dev--test-ip-v6"
+			azureIPv6Name = "shoot--dev--test-ip-v6"
+		)
+
+		var (
+			newDualStackPubip func(ips map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus, deletionTimestamp *metav1.Time) *azurev1alpha1.PublicIPAddress
+			azureIPv4         *network.PublicIPAddress
+			azureIPv6         *network.PublicIPAddress
+		)
+
+		BeforeEach(func() {
+			newDualStackPubip = func(ips map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus, deletionTimestamp *metav1.Time) *azurev1alpha1.PublicIPAddress {
+				return &azurev1alpha1.PublicIPAddress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              serviceName,
+						Namespace:         namespace,
+						DeletionTimestamp: deletionTimestamp,
+					},
+					Spec: azurev1alpha1.PublicIPAddressSpec{
+						IPFamilies:  []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+						IPAddresses: []string{ipv4, ipv6},
+						Managed:     true,
+					},
+					Status: azurev1alpha1.PublicIPAddressStatus{
+						IPs: ips,
+					},
+				}
+			}
+			azureIPv4 = &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azureIPv4ID),
+				Name: pointer.StringPtr(azureIPv4Name),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ipv4),
+					ProvisioningState: pointer.StringPtr(string(network.Succeeded)),
+				},
+			}
+			azureIPv6 = &network.PublicIPAddress{
+				ID:   pointer.StringPtr(azureIPv6ID),
+				Name: pointer.StringPtr(azureIPv6Name),
+				PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+					IPAddress:         pointer.StringPtr(ipv6),
+					ProvisioningState: pointer.StringPtr(string(network.Succeeded)),
+				},
+			}
+		})
+
+		Describe("#CreateOrUpdate", func() {
+			It("should resolve and aggregate both IP families into status", func() {
+				pubip := newDualStackPubip(nil, nil)
+				pubipWithStatus := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, nil)
+
+				pubipUtils.EXPECT().GetByIP(ctx, ipv4).Return(azureIPv4, nil)
+				pubipUtils.EXPECT().GetByIP(ctx, ipv6).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+
+			It("should give up on a family whose lookup keeps failing after MaxGetAttempts and still resolve the other", func() {
+				pubip := newDualStackPubip(nil, nil)
+				pubip.Status.FailedOperations = []azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeGetPublicIPAddress,
+						IPFamily:     corev1.IPv4Protocol,
+						Attempts:     cfg.MaxGetAttempts + 1,
+						ErrorMessage: "could not get Azure public IP address by IP: test",
+						Timestamp:    now,
+					},
+				}
+				pubipWithStatus := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, nil)
+				pubipWithStatus.Status.FailedOperations = []azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeGetPublicIPAddress,
+						IPFamily:     corev1.IPv4Protocol,
+						Attempts:     cfg.MaxGetAttempts + 2,
+						ErrorMessage: "could not get Azure public IP address by IP: test",
+						Timestamp:    now,
+					},
+				}
+
+				pubipUtils.EXPECT().GetByIP(ctx, ipv4).Return(nil, errors.New("test"))
+				pubipUtils.EXPECT().GetByIP(ctx, ipv6).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should remove both families from the load balancer in a single call and delete both", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(azureIPv4, nil)
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{azureIPv4ID, azureIPv6ID}).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv4Name).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv6Name).Return(nil)
+				cleanedIPsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should still delete both families for a pre-existing object with Managed unset", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+				pubip.Spec.Managed = false // zero value, as for an object that predates this field
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(azureIPv4, nil)
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{azureIPv4ID, azureIPv6ID}).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv4Name).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv6Name).Return(nil)
+				cleanedIPsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should give up on a family whose lookup keeps failing after MaxGetAttempts and still clean up the other", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+				pubip.Status.FailedOperations = []azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeGetPublicIPAddress,
+						IPFamily:     corev1.IPv4Protocol,
+						Attempts:     cfg.MaxGetAttempts + 1,
+						ErrorMessage: "could not get Azure public IP address by name: test",
+						Timestamp:    now,
+					},
+				}
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(nil, errors.New("test"))
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+				pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{azureIPv6ID}).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv6Name).Return(nil)
+				cleanedIPsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should reconcile a family stuck in a non-terminal provisioning state and requeue without deleting either family", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Updating))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+				stuckAzureIPv4 := &network.PublicIPAddress{
+					ID:   pointer.StringPtr(azureIPv4ID),
+					Name: pointer.StringPtr(azureIPv4Name),
+					PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+						IPAddress:         pointer.StringPtr(ipv4),
+						ProvisioningState: pointer.StringPtr(string(network.Updating)),
+					},
+				}
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(stuckAzureIPv4, nil)
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+				pubipUtils.EXPECT().Reconcile(ctx, azureIPv4Name).Return(stuckAzureIPv4, nil)
+
+				err := actuator.Delete(ctx, pubip)
+				Expect(err).Should(HaveOccurred())
+
+				requeuAfterError, ok := err.(*controllererror.RequeueAfterError)
+				Expect(ok).To(BeTrue())
+				Expect(requeuAfterError.Cause).To(MatchError("public IP address provisioning still in progress"))
+				Expect(requeuAfterError.RequeueAfter).To(Equal(cfg.RequeueInterval.Duration))
+			})
+
+			It("should give up reconciling a family stuck in a non-terminal provisioning state after MaxReconcileAttempts and still clean up the other family", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Updating))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+				pubip.Status.FailedOperations = []azurev1alpha1.FailedOperation{
+					{
+						Type:         azurev1alpha1.OperationTypeReconcilePublicIPAddress,
+						IPFamily:     corev1.IPv4Protocol,
+						Attempts:     cfg.MaxReconcileAttempts + 1,
+						ErrorMessage: "public IP address provisioning still in progress",
+						Timestamp:    now,
+					},
+				}
+				stuckAzureIPv4 := &network.PublicIPAddress{
+					ID:   pointer.StringPtr(azureIPv4ID),
+					Name: pointer.StringPtr(azureIPv4Name),
+					PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+						IPAddress:         pointer.StringPtr(ipv4),
+						ProvisioningState: pointer.StringPtr(string(network.Updating)),
+					},
+				}
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(stuckAzureIPv4, nil)
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubip).Return(nil)
+
+				pubipUtils.EXPECT().Reconcile(ctx, azureIPv4Name).Return(stuckAzureIPv4, nil)
+
+				pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{azureIPv6ID}).Return(nil)
+				pubipUtils.EXPECT().Delete(ctx, azureIPv6Name).Return(nil)
+				cleanedIPsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should refresh status but suppress the load balancer and delete calls in migration mode", func() {
+				pubip := newDualStackPubip(map[corev1.IPFamily]azurev1alpha1.PublicIPAddressIPStatus{
+					corev1.IPv4Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv4ID), Name: pointer.StringPtr(azureIPv4Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+					corev1.IPv6Protocol: {Exists: true, ID: pointer.StringPtr(azureIPv6ID), Name: pointer.StringPtr(azureIPv6Name), ProvisioningState: pointer.StringPtr(string(network.Succeeded))},
+				}, &earlyDeletionTimestamp)
+				pubip.Annotations = map[string]string{azurev1alpha1.AnnotationMigration: "true"}
+
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv4Name).Return(azureIPv4, nil)
+				pubipUtils.EXPECT().GetByName(ctx, azureIPv6Name).Return(azureIPv6, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				suppressedOperationsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+		})
+	})
+
+	Describe("migration mode", func() {
+		Describe("#CreateOrUpdate", func() {
+			It("should still refresh the status", func() {
+				pubip := newPubip(false, nil, nil)
+				pubip.Annotations = map[string]string{azurev1alpha1.AnnotationMigration: "true"}
+				pubipWithStatus := newPubip(true, nil, nil)
+				pubipWithStatus.Annotations = map[string]string{azurev1alpha1.AnnotationMigration: "true"}
+
+				pubipUtils.EXPECT().GetByIP(ctx, ip).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should suppress the load balancer and delete calls if the object carries the migration annotation, even past the deletion grace period", func() {
+				pubip := newPubip(true, nil, &earlyDeletionTimestamp)
+				pubip.Annotations = map[string]string{azurev1alpha1.AnnotationMigration: "true"}
+
+				pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				suppressedOperationsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should suppress the load balancer and delete calls if the shoot-wide MigrationMode flag is set, even past the deletion grace period", func() {
+				cfg.MigrationMode = true
+				migratingActuator := publicipaddress.NewActuator(pubipUtils, cfg, timestamper, logger, cleanedIPsCounter, suppressedOperationsCounter, pubipcache.Metrics{})
+				Expect(migratingActuator.(inject.Client).InjectClient(c)).To(Succeed())
+
+				pubip := newPubip(true, nil, &earlyDeletionTimestamp)
+
+				pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				suppressedOperationsCounter.EXPECT().Inc()
+
+				Expect(migratingActuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+
+			It("should suppress the load balancer and delete calls even within the deletion grace period", func() {
+				pubip := newPubip(true, nil, &now)
+				pubip.Annotations = map[string]string{azurev1alpha1.AnnotationMigration: "true"}
+
+				pubipUtils.EXPECT().GetByName(ctx, azurePublicIPAddressName).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				suppressedOperationsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+		})
+	})
+
+	Describe("BYO public IP addresses", func() {
+		const (
+			byoName     = "my-byo-ip"
+			byoPrefixID = "/subscriptions/xxx/resourceGroups/shoot--dev--test/providers/Microsoft.Network/publicIPPrefixes/my-prefix"
+		)
+
+		Describe("#CreateOrUpdate", func() {
+			It("should look up the Azure public IP address by the name given in the spec", func() {
+				pubip := newPubip(false, nil, nil)
+				pubip.Spec.Name = pointer.StringPtr(byoName)
+				pubip.Spec.Managed = false
+				pubipWithStatus := newPubip(true, nil, nil)
+				pubipWithStatus.Spec.Name = pointer.StringPtr(byoName)
+				pubipWithStatus.Spec.Managed = false
+
+				pubipUtils.EXPECT().GetByName(ctx, byoName).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+
+			It("should resolve the Azure public IP address from a public IP prefix", func() {
+				pubip := newPubip(false, nil, nil)
+				pubip.Spec.PrefixID = pointer.StringPtr(byoPrefixID)
+				pubip.Spec.Managed = false
+				pubipWithStatus := newPubip(true, nil, nil)
+				pubipWithStatus.Spec.PrefixID = pointer.StringPtr(byoPrefixID)
+				pubipWithStatus.Spec.Managed = false
+
+				pubipUtils.EXPECT().ListByPrefix(ctx, byoPrefixID).Return([]*network.PublicIPAddress{azurePublicIPAddress}, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+
+			It("should disambiguate among several prefix children by IP address before a name has been resolved", func() {
+				otherAzurePublicIPAddress := &network.PublicIPAddress{
+					ID:   pointer.StringPtr(azurePublicIPAddressID + "-other"),
+					Name: pointer.StringPtr(azurePublicIPAddressName + "-other"),
+					PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+						IPAddress:         pointer.StringPtr("5.6.7.8"),
+						ProvisioningState: pointer.StringPtr(string(network.Succeeded)),
+					},
+				}
+
+				pubip := newPubip(false, nil, nil)
+				pubip.Spec.PrefixID = pointer.StringPtr(byoPrefixID)
+				pubip.Spec.Managed = false
+				pubipWithStatus := newPubip(true, nil, nil)
+				pubipWithStatus.Spec.PrefixID = pointer.StringPtr(byoPrefixID)
+				pubipWithStatus.Spec.Managed = false
+
+				pubipUtils.EXPECT().ListByPrefix(ctx, byoPrefixID).Return(
+					[]*network.PublicIPAddress{otherAzurePublicIPAddress, azurePublicIPAddress}, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+				sw.EXPECT().Update(ctx, pubipWithStatus).Return(nil)
+
+				requeueAfter, removeFinalizer, err := actuator.CreateOrUpdate(ctx, pubip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeueAfter).To(Equal(time.Duration(0)))
+				Expect(removeFinalizer).To(Equal(false))
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should remove an unmanaged public IP address from the load balancer but not delete it", func() {
+				pubip := newPubip(true, nil, &earlyDeletionTimestamp)
+				pubip.Spec.Name = pointer.StringPtr(byoName)
+				pubip.Spec.Managed = false
+
+				pubipUtils.EXPECT().GetByName(ctx, byoName).Return(azurePublicIPAddress, nil)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: pubip.Namespace, Name: pubip.Name}, pubip).Return(nil)
+
+				pubipUtils.EXPECT().RemoveFromLoadBalancer(ctx, []string{azurePublicIPAddressID}).Return(nil)
+				cleanedIPsCounter.EXPECT().Inc()
+
+				Expect(actuator.Delete(ctx, pubip)).Should(Succeed())
+			})
+		})
 	})
 })
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publicipaddress
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/gardener/remedy-controller/pkg/apis/azure/v1alpha1"
+)
+
+// addFailedOperation records a failed attempt of the given operation type and IP family, bumping the
+// attempt counter if one is already being tracked.
+func addFailedOperation(
+	failedOperations []azurev1alpha1.FailedOperation,
+	opType azurev1alpha1.OperationType,
+	family corev1.IPFamily,
+	err error,
+	now metav1.Time,
+) []azurev1alpha1.FailedOperation {
+	for i := range failedOperations {
+		if failedOperations[i].Type == opType && failedOperations[i].IPFamily == family {
+			failedOperations[i].Attempts++
+			failedOperations[i].ErrorMessage = err.Error()
+			failedOperations[i].Timestamp = now
+			return failedOperations
+		}
+	}
+	return append(failedOperations, azurev1alpha1.FailedOperation{
+		Type:         opType,
+		IPFamily:     family,
+		Attempts:     1,
+		ErrorMessage: err.Error(),
+		Timestamp:    now,
+	})
+}
+
+// removeFailedOperation clears any tracked failure of the given operation type and IP family.
+func removeFailedOperation(
+	failedOperations []azurev1alpha1.FailedOperation,
+	opType azurev1alpha1.OperationType,
+	family corev1.IPFamily,
+) []azurev1alpha1.FailedOperation {
+	result := make([]azurev1alpha1.FailedOperation, 0, len(failedOperations))
+	for _, fo := range failedOperations {
+		if fo.Type != opType || fo.IPFamily != family {
+			result = append(result, fo)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// attemptsForOperation returns the number of attempts tracked for the given operation type and IP family.
+func attemptsForOperation(
+	failedOperations []azurev1alpha1.FailedOperation,
+	opType azurev1alpha1.OperationType,
+	family corev1.IPFamily,
+) int {
+	for _, fo := range failedOperations {
+		if fo.Type == opType && fo.IPFamily == family {
+			return fo.Attempts
+		}
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service holds scaffolding for a future service-watching reconciler that would construct
+// PublicIPAddress objects from Services and their Azure BYO-IP annotations. No such reconciler exists in
+// this tree yet, so nothing in this package is called outside of its own tests; the BYO name/prefix feature
+// it models is not reachable end-to-end until one is wired in.
+package service
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	azurev1alpha1 "github.com/gardener/remedy-controller/pkg/apis/azure/v1alpha1"
+)
+
+const (
+	// annotationPublicIPName is the annotation by which the Azure cloud provider lets users bring their own
+	// preallocated Azure public IP address for a LoadBalancer Service.
+	annotationPublicIPName = "service.beta.kubernetes.io/azure-pip-name"
+	// annotationPublicIPPrefixID is the annotation by which the Azure cloud provider lets users draw the
+	// Azure public IP address for a LoadBalancer Service from a public IP prefix.
+	annotationPublicIPPrefixID = "service.beta.kubernetes.io/azure-pip-prefix-id"
+)
+
+// PublicIPAddressSpecForService derives the PublicIPAddressSpec for the Azure public IP address backing the
+// given Service and IP address, propagating any user-specified azure-pip-name or azure-pip-prefix-id
+// annotations. A Service without either annotation is considered managed by this controller.
+func PublicIPAddressSpecForService(svc *corev1.Service, ip string) azurev1alpha1.PublicIPAddressSpec {
+	spec := azurev1alpha1.PublicIPAddressSpec{
+		IPAddress: ip,
+		Managed:   true,
+	}
+	if name := svc.Annotations[annotationPublicIPName]; name != "" {
+		spec.Name = pointer.StringPtr(name)
+		spec.Managed = false
+	}
+	if prefixID := svc.Annotations[annotationPublicIPPrefixID]; prefixID != "" {
+		spec.PrefixID = pointer.StringPtr(prefixID)
+		spec.Managed = false
+	}
+	return spec
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	azurev1alpha1 "github.com/gardener/remedy-controller/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/remedy-controller/pkg/controller/azure/service"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("PublicIPAddressSpecForService", func() {
+	const ip = "1.2.3.4"
+
+	It("should produce a managed spec if no BYO annotations are present", func() {
+		svc := &corev1.Service{}
+
+		Expect(service.PublicIPAddressSpecForService(svc, ip)).To(Equal(azurev1alpha1.PublicIPAddressSpec{
+			IPAddress: ip,
+			Managed:   true,
+		}))
+	})
+
+	It("should propagate the azure-pip-name annotation and mark the spec unmanaged", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"service.beta.kubernetes.io/azure-pip-name": "my-byo-ip",
+				},
+			},
+		}
+
+		Expect(service.PublicIPAddressSpecForService(svc, ip)).To(Equal(azurev1alpha1.PublicIPAddressSpec{
+			IPAddress: ip,
+			Name:      pointer.StringPtr("my-byo-ip"),
+			Managed:   false,
+		}))
+	})
+
+	It("should propagate the azure-pip-prefix-id annotation and mark the spec unmanaged", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"service.beta.kubernetes.io/azure-pip-prefix-id": "/subscriptions/xxx/.../publicIPPrefixes/my-prefix",
+				},
+			},
+		}
+
+		Expect(service.PublicIPAddressSpecForService(svc, ip)).To(Equal(azurev1alpha1.PublicIPAddressSpec{
+			IPAddress: ip,
+			PrefixID:  pointer.StringPtr("/subscriptions/xxx/.../publicIPPrefixes/my-prefix"),
+			Managed:   false,
+		}))
+	})
+})
@@ -0,0 +1,32 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Actuator reconciles a single remedy object against the backing cloud provider resource it tracks.
+type Actuator interface {
+	// CreateOrUpdate reconciles the cloud provider resource for the given object and updates its status
+	// accordingly. It returns a duration after which reconciliation should be requeued, whether the object's
+	// finalizer may be removed, and an error if reconciliation could not be completed.
+	CreateOrUpdate(ctx context.Context, obj runtime.Object) (requeueAfter time.Duration, removeFinalizer bool, err error)
+	// Delete cleans up the cloud provider resource for the given object, which is being deleted.
+	Delete(ctx context.Context, obj runtime.Object) error
+}
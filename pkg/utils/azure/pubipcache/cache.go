@@ -0,0 +1,179 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pubipcache provides a caching decorator for azureutils.PublicIPAddressUtils that batches GetByIP
+// and GetByName lookups behind a single Azure public IP address listing, to reduce the number of Azure
+// Resource Manager calls issued across many reconciles. The listing is refreshed by the first lookup that
+// finds it older than the TTL; there is no background refresh.
+package pubipcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-11-01/network"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/remedy-controller/pkg/utils"
+	azureutils "github.com/gardener/remedy-controller/pkg/utils/azure"
+)
+
+// Metrics holds the Prometheus counters recorded by a Cache.
+type Metrics struct {
+	// Hits counts GetByIP and GetByName calls served from the cached listing, whether fresh or stale.
+	Hits prometheus.Counter
+	// Misses counts GetByIP and GetByName calls for which the cached listing, whether fresh or stale,
+	// contained no match.
+	Misses prometheus.Counter
+	// RefreshFailures counts failed attempts to refresh an existing cached listing. A failure to build the
+	// first listing is returned to the caller and not counted.
+	RefreshFailures prometheus.Counter
+	// StaleServed counts GetByIP and GetByName calls served from an expired cached listing after a refresh
+	// failure.
+	StaleServed prometheus.Counter
+}
+
+// cache decorates an azureutils.PublicIPAddressUtils, serving GetByIP and GetByName from an in-memory index
+// that is rebuilt from a single List call no more often than every ttl. All other methods are passed
+// through to next unmodified.
+type cache struct {
+	next        azureutils.PublicIPAddressUtils
+	ttl         time.Duration
+	timestamper utils.Timestamper
+	metrics     Metrics
+
+	mu          sync.Mutex
+	byIP        map[string]*network.PublicIPAddress
+	byName      map[string]*network.PublicIPAddress
+	refreshedAt *metav1.Time
+}
+
+// New returns an azureutils.PublicIPAddressUtils that serves GetByIP and GetByName from a listing of next
+// that is refreshed at most once per ttl, falling back to a stale listing if a refresh fails. A ttl of zero
+// or less disables caching and New returns next unchanged.
+func New(next azureutils.PublicIPAddressUtils, ttl time.Duration, timestamper utils.Timestamper, metrics Metrics) azureutils.PublicIPAddressUtils {
+	if ttl <= 0 {
+		return next
+	}
+	return &cache{
+		next:        next,
+		ttl:         ttl,
+		timestamper: timestamper,
+		metrics:     metrics,
+	}
+}
+
+// GetByIP returns the Azure public IP address with the given IP address, or nil if it doesn't exist, serving
+// the result from the cached listing where possible.
+func (c *cache) GetByIP(ctx context.Context, ip string) (*network.PublicIPAddress, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	if pip, ok := c.byIP[ip]; ok {
+		c.metrics.Hits.Inc()
+		return pip, nil
+	}
+	c.metrics.Misses.Inc()
+	return nil, nil
+}
+
+// GetByName returns the Azure public IP address with the given name, or nil if it doesn't exist, serving the
+// result from the cached listing where possible.
+func (c *cache) GetByName(ctx context.Context, name string) (*network.PublicIPAddress, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	if pip, ok := c.byName[name]; ok {
+		c.metrics.Hits.Inc()
+		return pip, nil
+	}
+	c.metrics.Misses.Inc()
+	return nil, nil
+}
+
+// ListByPrefix is passed through to the wrapped PublicIPAddressUtils unmodified.
+func (c *cache) ListByPrefix(ctx context.Context, prefixID string) ([]*network.PublicIPAddress, error) {
+	return c.next.ListByPrefix(ctx, prefixID)
+}
+
+// List is passed through to the wrapped PublicIPAddressUtils unmodified.
+func (c *cache) List(ctx context.Context) ([]*network.PublicIPAddress, error) {
+	return c.next.List(ctx)
+}
+
+// RemoveFromLoadBalancer is passed through to the wrapped PublicIPAddressUtils unmodified.
+func (c *cache) RemoveFromLoadBalancer(ctx context.Context, ids []string) error {
+	return c.next.RemoveFromLoadBalancer(ctx, ids)
+}
+
+// Reconcile is passed through to the wrapped PublicIPAddressUtils unmodified.
+func (c *cache) Reconcile(ctx context.Context, name string) (*network.PublicIPAddress, error) {
+	return c.next.Reconcile(ctx, name)
+}
+
+// Delete deletes the Azure public IP address with the given name and invalidates the cached listing, so that
+// the next GetByIP or GetByName call doesn't re-observe the just-deleted address.
+func (c *cache) Delete(ctx context.Context, name string) error {
+	if err := c.next.Delete(ctx, name); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshedAt = nil
+	return nil
+}
+
+// refreshLocked rebuilds the cached listing if its ttl has expired. If the refresh fails and a previous
+// listing is still available, the stale listing is kept and served instead, and a refresh failure and a
+// stale-served read are recorded; otherwise the error is returned to the caller.
+func (c *cache) refreshLocked(ctx context.Context) error {
+	if c.refreshedAt != nil && c.timestamper.Now().Sub(c.refreshedAt.Time) < c.ttl {
+		return nil
+	}
+
+	pips, err := c.next.List(ctx)
+	if err != nil {
+		if c.refreshedAt == nil {
+			return errors.Wrap(err, "could not refresh Azure public IP address cache")
+		}
+		c.metrics.RefreshFailures.Inc()
+		c.metrics.StaleServed.Inc()
+		return nil
+	}
+
+	byIP := make(map[string]*network.PublicIPAddress, len(pips))
+	byName := make(map[string]*network.PublicIPAddress, len(pips))
+	for _, pip := range pips {
+		if pip.PublicIPAddressPropertiesFormat != nil && pip.IPAddress != nil {
+			byIP[*pip.IPAddress] = pip
+		}
+		if pip.Name != nil {
+			byName[*pip.Name] = pip
+		}
+	}
+	c.byIP = byIP
+	c.byName = byName
+	now := c.timestamper.Now()
+	c.refreshedAt = &now
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubipcache_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-11-01/network"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	mockprometheus "github.com/gardener/remedy-controller/pkg/mock/prometheus"
+	mockutilsazure "github.com/gardener/remedy-controller/pkg/mock/remedy-controller/utils/azure"
+	"github.com/gardener/remedy-controller/pkg/utils"
+	azureutils "github.com/gardener/remedy-controller/pkg/utils/azure"
+	"github.com/gardener/remedy-controller/pkg/utils/azure/pubipcache"
+)
+
+var _ = Describe("Cache", func() {
+	const (
+		ttl  = 1 * time.Minute
+		ip   = "1.2.3.4"
+		name = "shoot--dev--test-ip1"
+	)
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		next            *mockutilsazure.MockPublicIPAddressUtils
+		hitCounter      *mockprometheus.MockCounter
+		missCounter     *mockprometheus.MockCounter
+		refreshFailures *mockprometheus.MockCounter
+		staleServed     *mockprometheus.MockCounter
+
+		now         metav1.Time
+		timestamper utils.Timestamper
+
+		pubipUtils azureutils.PublicIPAddressUtils
+		azureIP    *network.PublicIPAddress
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		next = mockutilsazure.NewMockPublicIPAddressUtils(ctrl)
+		hitCounter = mockprometheus.NewMockCounter(ctrl)
+		missCounter = mockprometheus.NewMockCounter(ctrl)
+		refreshFailures = mockprometheus.NewMockCounter(ctrl)
+		staleServed = mockprometheus.NewMockCounter(ctrl)
+
+		now = metav1.Now()
+		timestamper = utils.TimestamperFunc(func() metav1.Time { return now })
+
+		pubipUtils = pubipcache.New(next, ttl, timestamper, pubipcache.Metrics{
+			Hits:            hitCounter,
+			Misses:          missCounter,
+			RefreshFailures: refreshFailures,
+			StaleServed:     staleServed,
+		})
+
+		azureIP = &network.PublicIPAddress{
+			Name: pointer.StringPtr(name),
+			PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+				IPAddress: pointer.StringPtr(ip),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#New", func() {
+		It("should return the wrapped PublicIPAddressUtils unchanged if ttl is zero", func() {
+			Expect(pubipcache.New(next, 0, timestamper, pubipcache.Metrics{})).To(BeIdenticalTo(next))
+		})
+	})
+
+	Describe("#GetByIP", func() {
+		It("should list once and serve repeated lookups from the cache", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil)
+			hitCounter.EXPECT().Inc().Times(2)
+
+			for i := 0; i < 2; i++ {
+				result, err := pubipUtils.GetByIP(ctx, ip)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(azureIP))
+			}
+		})
+
+		It("should record a miss if no cached address matches", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil)
+			missCounter.EXPECT().Inc()
+
+			result, err := pubipUtils.GetByIP(ctx, "5.6.7.8")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should refresh again once the ttl has elapsed", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil).Times(2)
+			hitCounter.EXPECT().Inc().Times(2)
+
+			_, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+
+			now = metav1.NewTime(now.Add(ttl))
+
+			_, err = pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the refresh error if no cached listing is available yet", func() {
+			next.EXPECT().List(ctx).Return(nil, errors.New("test"))
+
+			_, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).To(MatchError("could not refresh Azure public IP address cache: test"))
+		})
+
+		It("should serve a stale listing and record it if a refresh fails", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil)
+			hitCounter.EXPECT().Inc()
+
+			_, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+
+			now = metav1.NewTime(now.Add(ttl))
+			next.EXPECT().List(ctx).Return(nil, errors.New("test"))
+			refreshFailures.EXPECT().Inc()
+			staleServed.EXPECT().Inc()
+			hitCounter.EXPECT().Inc()
+
+			result, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(azureIP))
+		})
+	})
+
+	Describe("#GetByName", func() {
+		It("should list once and serve repeated lookups from the cache", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil)
+			hitCounter.EXPECT().Inc().Times(2)
+
+			for i := 0; i < 2; i++ {
+				result, err := pubipUtils.GetByName(ctx, name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(azureIP))
+			}
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should invalidate the cache so that the next lookup triggers a fresh listing", func() {
+			next.EXPECT().List(ctx).Return([]*network.PublicIPAddress{azureIP}, nil)
+			hitCounter.EXPECT().Inc()
+			_, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+
+			next.EXPECT().Delete(ctx, name).Return(nil)
+			Expect(pubipUtils.Delete(ctx, name)).To(Succeed())
+
+			next.EXPECT().List(ctx).Return(nil, nil)
+			missCounter.EXPECT().Inc()
+			result, err := pubipUtils.GetByIP(ctx, ip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Timestamper provides the current time. It exists so that tests can substitute a fixed clock.
+type Timestamper interface {
+	// Now returns the current time.
+	Now() metav1.Time
+}
+
+// TimestamperFunc is a function that implements Timestamper.
+type TimestamperFunc func() metav1.Time
+
+// Now returns the current time.
+func (f TimestamperFunc) Now() metav1.Time {
+	return f()
+}